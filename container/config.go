@@ -2,6 +2,7 @@ package container
 
 import (
 	"fmt"
+	"os"
 	"time"
 
 	endure "github.com/roadrunner-server/endure/pkg/container"
@@ -29,6 +30,17 @@ func NewConfig(cfgFile string) (*Config, error) {
 		return nil, err
 	}
 
+	// automatically inject ENV variables using ${ENV} pattern
+	for _, key := range v.AllKeys() {
+		val := v.Get(key)
+		if s, ok := val.(string); ok {
+			val = os.ExpandEnv(s)
+		}
+
+		// non-string values should be set as well, otherwise they will be shadowed by the overridden siblings
+		v.Set(key, val)
+	}
+
 	if !v.IsSet(endureKey) {
 		return &Config{ // return config with defaults
 			GracePeriod: defaultGracePeriod,
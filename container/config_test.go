@@ -20,6 +20,20 @@ func TestNewConfig_SuccessfulReading(t *testing.T) {
 	assert.Equal(t, endure.WarnLevel, c.LogLevel)
 }
 
+func TestNewConfig_ConfigFormats(t *testing.T) {
+	want, err := container.NewConfig("test/endure_ok.yaml")
+	assert.NoError(t, err)
+
+	for _, path := range []string{"test/endure_ok.json", "test/endure_ok.toml"} {
+		path := path
+		t.Run(path, func(t *testing.T) {
+			c, err := container.NewConfig(path)
+			assert.NoError(t, err)
+			assert.Equal(t, want, c)
+		})
+	}
+}
+
 func TestNewConfig_WithoutEndureKey(t *testing.T) {
 	cfgPlugin := &config.Plugin{Type: "yaml", ReadInCfg: []byte{}}
 	assert.NoError(t, cfgPlugin.Init())
@@ -67,3 +81,16 @@ func TestNewConfig_LoggingLevels(t *testing.T) {
 		})
 	}
 }
+
+func TestNewConfig_EnvDollarSyntax(t *testing.T) {
+	t.Setenv("RR_TEST_GRACE_PERIOD", "15s")
+	t.Setenv("RR_TEST_LOG_LEVEL", "info")
+
+	c, err := container.NewConfig("test/endure_ok_env.yaml")
+	assert.NoError(t, err)
+	assert.NotNil(t, c)
+
+	assert.Equal(t, time.Second*15, c.GracePeriod)
+	assert.True(t, c.PrintGraph)
+	assert.Equal(t, endure.InfoLevel, c.LogLevel)
+}
@@ -3,6 +3,8 @@ package rpc_test
 import (
 	"net"
 	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
 
 	"github.com/roadrunner-server/roadrunner/v2/internal/rpc"
@@ -96,3 +98,35 @@ func TestNewClient_SuccessfullyConnectedEnvDollarSyntax(t *testing.T) {
 
 	defer func() { assert.NoError(t, c.Close()) }()
 }
+
+func TestDialer_AddressForms(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		network string
+		address string
+		dialDSN func(addr net.Addr) string
+	}{
+		{name: "ipv4", network: "tcp", address: "127.0.0.1:0", dialDSN: func(addr net.Addr) string { return "tcp://" + addr.String() }},
+		{name: "ipv4 forced", network: "tcp4", address: "127.0.0.1:0", dialDSN: func(addr net.Addr) string { return "tcp4://" + addr.String() }},
+		{name: "ipv6 literal", network: "tcp6", address: "[::1]:0", dialDSN: func(addr net.Addr) string { return "tcp://" + addr.String() }},
+		{name: "ipv6 forced", network: "tcp6", address: "[::1]:0", dialDSN: func(addr net.Addr) string { return "tcp6://" + addr.String() }},
+		{name: "hostname", network: "tcp", address: "127.0.0.1:0", dialDSN: func(addr net.Addr) string {
+			return "tcp://" + net.JoinHostPort("localhost", strconv.Itoa(addr.(*net.TCPAddr).Port))
+		}},
+		{name: "unix socket", network: "unix", address: filepath.Join(t.TempDir(), "rr.sock"), dialDSN: func(addr net.Addr) string { return "unix://" + addr.String() }},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := net.Listen(tt.network, tt.address)
+			if err != nil {
+				t.Skipf("%s is not available: %v", tt.network, err)
+			}
+
+			defer func() { assert.NoError(t, l.Close()) }()
+
+			conn, err := rpc.Dialer(tt.dialDSN(l.Addr()))
+			require.NoError(t, err)
+			assert.NoError(t, conn.Close())
+		})
+	}
+}